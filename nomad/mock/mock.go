@@ -548,6 +548,29 @@ func PlanResult() *structs.PlanResult {
 	return &structs.PlanResult{}
 }
 
+// Resources returns a resources object with the given CPU, memory and disk
+// along with any passed networks.
+func Resources(cpu, memoryMB, diskMB int, networks ...*structs.NetworkResource) *structs.Resources {
+	r := &structs.Resources{
+		CPU:      cpu,
+		MemoryMB: memoryMB,
+		DiskMB:   diskMB,
+	}
+	if len(networks) != 0 {
+		r.Networks = networks
+	}
+	return r
+}
+
+// Network returns a network resource on the given device with the given
+// bandwidth.
+func Network(device string, mbits int) *structs.NetworkResource {
+	return &structs.NetworkResource{
+		Device: device,
+		MBits:  mbits,
+	}
+}
+
 func ACLPolicy() *structs.ACLPolicy {
 	ap := &structs.ACLPolicy{
 		Name:        fmt.Sprintf("policy-%s", uuid.Generate()),
@@ -195,6 +195,53 @@ func ScoreFit(node *Node, util *Resources) float64 {
 	return score
 }
 
+// FormatResourceDiff returns a human readable description of how the reclaimed
+// resources differ from the ask, such as "CPU +500, MemoryMB -256". Only
+// dimensions that differ are listed, with network bandwidth compared per
+// device. An empty string is returned if the two are equal.
+func FormatResourceDiff(reclaimed, ask *Resources) string {
+	if reclaimed == nil {
+		reclaimed = &Resources{}
+	}
+	if ask == nil {
+		ask = &Resources{}
+	}
+
+	var parts []string
+	addDiff := func(name string, diff int) {
+		if diff != 0 {
+			parts = append(parts, fmt.Sprintf("%s %+d", name, diff))
+		}
+	}
+	addDiff("CPU", reclaimed.CPU-ask.CPU)
+	addDiff("MemoryMB", reclaimed.MemoryMB-ask.MemoryMB)
+	addDiff("DiskMB", reclaimed.DiskMB-ask.DiskMB)
+	addDiff("IOPS", reclaimed.IOPS-ask.IOPS)
+
+	// Sum the bandwidth per device so devices are listed in a stable order
+	mbits := make(map[string]int)
+	for _, n := range reclaimed.Networks {
+		mbits[n.Device] += n.MBits
+	}
+	for _, n := range ask.Networks {
+		mbits[n.Device] -= n.MBits
+	}
+	devices := make([]string, 0, len(mbits))
+	for device := range mbits {
+		devices = append(devices, device)
+	}
+	sort.Strings(devices)
+	for _, device := range devices {
+		name := "MBits"
+		if device != "" {
+			name = fmt.Sprintf("MBits (%s)", device)
+		}
+		addDiff(name, mbits[device])
+	}
+
+	return strings.Join(parts, ", ")
+}
+
 func CopySliceConstraints(s []*Constraint) []*Constraint {
 	l := len(s)
 	if l == 0 {
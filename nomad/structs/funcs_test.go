@@ -258,6 +258,56 @@ func TestScoreFit(t *testing.T) {
 	}
 }
 
+func TestFormatResourceDiff(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Reclaimed *Resources
+		Ask       *Resources
+		Expected  string
+	}{
+		{
+			Name:      "equal",
+			Reclaimed: &Resources{CPU: 500, MemoryMB: 256},
+			Ask:       &Resources{CPU: 500, MemoryMB: 256},
+			Expected:  "",
+		},
+		{
+			Name:      "surplus and shortfall",
+			Reclaimed: &Resources{CPU: 1000, MemoryMB: 256, DiskMB: 100},
+			Ask:       &Resources{CPU: 500, MemoryMB: 512, DiskMB: 100},
+			Expected:  "CPU +500, MemoryMB -256",
+		},
+		{
+			Name:      "nil ask",
+			Reclaimed: &Resources{MemoryMB: 1024, IOPS: 5},
+			Expected:  "MemoryMB +1024, IOPS +5",
+		},
+		{
+			Name: "networks",
+			Reclaimed: &Resources{
+				Networks: []*NetworkResource{
+					{Device: "eth1", MBits: 100},
+					{Device: "eth0", MBits: 20},
+					{MBits: 5},
+				},
+			},
+			Ask: &Resources{
+				Networks: []*NetworkResource{
+					{Device: "eth0", MBits: 50},
+					{Device: "eth1", MBits: 100},
+				},
+			},
+			Expected: "MBits +5, MBits (eth0) -30",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			assert.Equal(t, c.Expected, FormatResourceDiff(c.Reclaimed, c.Ask))
+		})
+	}
+}
+
 func TestACLPolicyListHash(t *testing.T) {
 	h1 := ACLPolicyListHash(nil)
 	assert.NotEqual(t, "", h1)
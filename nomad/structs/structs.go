@@ -1719,6 +1719,35 @@ func (r *Resources) MeetsMinResources() error {
 	return mErr.ErrorOrNil()
 }
 
+// Validate returns an error if the resources are malformed, such as having a
+// negative value for any dimension or an invalid network.
+func (r *Resources) Validate() error {
+	var mErr multierror.Error
+	if r.CPU < 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("CPU cannot be negative; got %d", r.CPU))
+	}
+	if r.MemoryMB < 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("MemoryMB cannot be negative; got %d", r.MemoryMB))
+	}
+	if r.DiskMB < 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("DiskMB cannot be negative; got %d", r.DiskMB))
+	}
+	if r.IOPS < 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("IOPS cannot be negative; got %d", r.IOPS))
+	}
+	for i, n := range r.Networks {
+		if n == nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("network resource at index %d is nil", i))
+			continue
+		}
+		if err := n.Validate(); err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("network resource at index %d failed: %v", i, err))
+		}
+	}
+
+	return mErr.ErrorOrNil()
+}
+
 // Copy returns a deep copy of the resources
 func (r *Resources) Copy() *Resources {
 	if r == nil {
@@ -1766,7 +1795,9 @@ func (r *Resources) Superset(other *Resources) (bool, string) {
 }
 
 // Add adds the resources of the delta to this, potentially
-// returning an error if not possible.
+// returning an error if not possible. Networks of the delta are matched to
+// this resource's networks by device name and summed; networks on a device
+// not yet present are appended as copies.
 func (r *Resources) Add(delta *Resources) error {
 	if delta == nil {
 		return nil
@@ -1777,7 +1808,7 @@ func (r *Resources) Add(delta *Resources) error {
 	r.IOPS += delta.IOPS
 
 	for _, n := range delta.Networks {
-		// Find the matching interface by IP or CIDR
+		// Find the matching interface by device name
 		idx := r.NetIndex(n)
 		if idx == -1 {
 			r.Networks = append(r.Networks, n.Copy())
@@ -1788,6 +1819,115 @@ func (r *Resources) Add(delta *Resources) error {
 	return nil
 }
 
+// Shortfall returns how much of each dimension of this ask is not covered by
+// the free resources, floored at zero. Network bandwidth is compared per
+// device and only networks with a shortfall are included; ports are ignored.
+func (r *Resources) Shortfall(free *Resources) *Resources {
+	if free == nil {
+		free = &Resources{}
+	}
+
+	short := &Resources{
+		CPU:      shortfall(r.CPU, free.CPU),
+		MemoryMB: shortfall(r.MemoryMB, free.MemoryMB),
+		DiskMB:   shortfall(r.DiskMB, free.DiskMB),
+		IOPS:     shortfall(r.IOPS, free.IOPS),
+	}
+
+	for _, n := range r.Networks {
+		freeMBits := 0
+		if idx := free.NetIndex(n); idx != -1 {
+			freeMBits = free.Networks[idx].MBits
+		}
+		if mbits := shortfall(n.MBits, freeMBits); mbits > 0 {
+			short.Networks = append(short.Networks, &NetworkResource{
+				Device: n.Device,
+				MBits:  mbits,
+			})
+		}
+	}
+	return short
+}
+
+// shortfall returns how far available is below needed, or zero if it isn't.
+func shortfall(needed, available int) int {
+	if needed > available {
+		return needed - available
+	}
+	return 0
+}
+
+// Max returns the name and value of the largest dimension of the resources,
+// using the same dimension names as Superset plus "bandwidth" for the MBits
+// summed across networks. Values are compared as-is, so callers comparing
+// dimensions with different units should normalize them first. Ties resolve
+// in the order cpu, memory, disk, iops, bandwidth. If no dimension is positive
+// an empty name and zero are returned.
+func (r *Resources) Max() (string, int) {
+	bandwidth := 0
+	for _, n := range r.Networks {
+		bandwidth += n.MBits
+	}
+
+	dimensions := []struct {
+		name  string
+		value int
+	}{
+		{"cpu", r.CPU},
+		{"memory", r.MemoryMB},
+		{"disk", r.DiskMB},
+		{"iops", r.IOPS},
+		{"bandwidth", bandwidth},
+	}
+
+	maxName, maxValue := "", 0
+	for _, d := range dimensions {
+		if d.value > maxValue {
+			maxName, maxValue = d.name, d.value
+		}
+	}
+	return maxName, maxValue
+}
+
+// Plus returns a new resources object that is the sum of this and other.
+// Unlike Add, neither operand is modified.
+func (r *Resources) Plus(other *Resources) *Resources {
+	if r == nil {
+		return other.Copy()
+	}
+	sum := r.Copy()
+	sum.Add(other)
+	return sum
+}
+
+// Scale returns a copy of the resources with every dimension, including the
+// bandwidth of each network, multiplied by factor and rounded up. Ports are
+// copied unchanged.
+func (r *Resources) Scale(factor float64) *Resources {
+	if r == nil {
+		return nil
+	}
+	scaled := r.Copy()
+	scaled.CPU = scaleResource(r.CPU, factor)
+	scaled.MemoryMB = scaleResource(r.MemoryMB, factor)
+	scaled.DiskMB = scaleResource(r.DiskMB, factor)
+	scaled.IOPS = scaleResource(r.IOPS, factor)
+	for _, n := range scaled.Networks {
+		n.MBits = scaleResource(n.MBits, factor)
+	}
+	return scaled
+}
+
+// scaleResource multiplies v by factor and rounds up. Products that are within
+// floating point error of a whole number are not bumped to the next integer.
+func scaleResource(v int, factor float64) int {
+	scaled := float64(v) * factor
+	if rounded := math.Round(scaled); math.Abs(scaled-rounded) < 1e-9 {
+		return int(rounded)
+	}
+	return int(math.Ceil(scaled))
+}
+
 func (r *Resources) GoString() string {
 	return fmt.Sprintf("*%#v", *r)
 }
@@ -1873,6 +2013,33 @@ func (n *NetworkResource) MeetsMinResources() error {
 	return mErr.ErrorOrNil()
 }
 
+// Validate returns an error if the network resource is malformed.
+func (n *NetworkResource) Validate() error {
+	var mErr multierror.Error
+	if n.MBits < 0 {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("MBits cannot be negative; got %d", n.MBits))
+	}
+	if n.CIDR != "" {
+		if _, _, err := net.ParseCIDR(n.CIDR); err != nil {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid CIDR %q: %v", n.CIDR, err))
+		}
+	}
+	if n.IP != "" && net.ParseIP(n.IP) == nil {
+		mErr.Errors = append(mErr.Errors, fmt.Errorf("invalid IP %q", n.IP))
+	}
+	for _, port := range n.ReservedPorts {
+		if port.Value < 0 || port.Value >= maxValidPort {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("reserved port %q has invalid value %d", port.Label, port.Value))
+		}
+	}
+	for _, port := range n.DynamicPorts {
+		if port.Value < 0 || port.Value >= maxValidPort {
+			mErr.Errors = append(mErr.Errors, fmt.Errorf("dynamic port %q has invalid value %d", port.Label, port.Value))
+		}
+	}
+	return mErr.ErrorOrNil()
+}
+
 // Copy returns a deep copy of the network resource
 func (n *NetworkResource) Copy() *NetworkResource {
 	if n == nil {
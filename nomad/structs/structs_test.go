@@ -1743,6 +1743,340 @@ func TestResource_Add_Network(t *testing.T) {
 	}
 }
 
+func TestResource_Add_NetworkBandwidth(t *testing.T) {
+	require := require.New(t)
+
+	deltas := []*Resources{
+		{Networks: []*NetworkResource{{Device: "eth0", MBits: 20}}},
+		{Networks: []*NetworkResource{{Device: "eth0", MBits: 30}}},
+		{Networks: []*NetworkResource{{Device: "eth0", MBits: 50}}},
+		{Networks: []*NetworkResource{{Device: "eth1", MBits: 10}}},
+	}
+
+	total := &Resources{}
+	for _, d := range deltas {
+		require.NoError(total.Add(d))
+	}
+
+	// Bandwidth on the same device is summed and other devices are appended
+	expect := []*NetworkResource{
+		{Device: "eth0", MBits: 100},
+		{Device: "eth1", MBits: 10},
+	}
+	require.Equal(expect, []*NetworkResource(total.Networks))
+
+	// The deltas must not be modified by the accumulation
+	require.Equal(20, deltas[0].Networks[0].MBits)
+}
+
+func TestResource_Shortfall(t *testing.T) {
+	require := require.New(t)
+
+	ask := &Resources{
+		CPU:      2000,
+		MemoryMB: 1024,
+		DiskMB:   500,
+		IOPS:     10,
+		Networks: []*NetworkResource{
+			{Device: "eth0", MBits: 100, ReservedPorts: []Port{{"web", 80}}},
+			{Device: "eth1", MBits: 50},
+		},
+	}
+	free := &Resources{
+		CPU:      1500,
+		MemoryMB: 2048,
+		DiskMB:   500,
+		Networks: []*NetworkResource{
+			{Device: "eth0", MBits: 40},
+			{Device: "eth1", MBits: 80},
+		},
+	}
+
+	expect := &Resources{
+		CPU:  500,
+		IOPS: 10,
+		Networks: []*NetworkResource{
+			{Device: "eth0", MBits: 60},
+		},
+	}
+	require.Equal(expect, ask.Shortfall(free))
+
+	// Nothing is free so the whole ask is short, without ports
+	expect = &Resources{
+		CPU:      2000,
+		MemoryMB: 1024,
+		DiskMB:   500,
+		IOPS:     10,
+		Networks: []*NetworkResource{
+			{Device: "eth0", MBits: 100},
+			{Device: "eth1", MBits: 50},
+		},
+	}
+	require.Equal(expect, ask.Shortfall(nil))
+
+	// Plenty free means no shortfall in any dimension
+	require.Equal(&Resources{}, ask.Shortfall(free.Plus(ask)))
+}
+
+func TestResource_Max(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Resources *Resources
+		Dimension string
+		Value     int
+	}{
+		{
+			Name:      "empty",
+			Resources: &Resources{},
+		},
+		{
+			Name:      "negative only",
+			Resources: &Resources{CPU: -100, MemoryMB: -20},
+		},
+		{
+			Name:      "memory",
+			Resources: &Resources{CPU: 500, MemoryMB: 1024, DiskMB: 300},
+			Dimension: "memory",
+			Value:     1024,
+		},
+		{
+			Name:      "tie prefers cpu",
+			Resources: &Resources{CPU: 1024, MemoryMB: 1024},
+			Dimension: "cpu",
+			Value:     1024,
+		},
+		{
+			Name:      "tie between disk and iops",
+			Resources: &Resources{DiskMB: 50, IOPS: 50},
+			Dimension: "disk",
+			Value:     50,
+		},
+		{
+			Name: "bandwidth summed across networks",
+			Resources: &Resources{
+				CPU: 100,
+				Networks: []*NetworkResource{
+					{Device: "eth0", MBits: 60},
+					{Device: "eth1", MBits: 60},
+				},
+			},
+			Dimension: "bandwidth",
+			Value:     120,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			dim, value := c.Resources.Max()
+			require.Equal(t, c.Dimension, dim)
+			require.Equal(t, c.Value, value)
+		})
+	}
+}
+
+func TestResource_Plus(t *testing.T) {
+	require := require.New(t)
+
+	r1 := &Resources{
+		CPU:      2000,
+		MemoryMB: 2048,
+		DiskMB:   10000,
+		IOPS:     100,
+		Networks: []*NetworkResource{
+			{
+				Device:        "eth0",
+				MBits:         100,
+				ReservedPorts: []Port{{"ssh", 22}},
+			},
+		},
+	}
+	r2 := &Resources{
+		CPU:      500,
+		MemoryMB: 1024,
+		DiskMB:   5000,
+		IOPS:     50,
+		Networks: []*NetworkResource{
+			{
+				Device:        "eth0",
+				MBits:         50,
+				ReservedPorts: []Port{{"web", 80}},
+			},
+			{
+				Device: "eth1",
+				MBits:  10,
+			},
+		},
+	}
+	r1Orig, r2Orig := r1.Copy(), r2.Copy()
+
+	expect := &Resources{
+		CPU:      2500,
+		MemoryMB: 3072,
+		DiskMB:   15000,
+		IOPS:     150,
+		Networks: []*NetworkResource{
+			{
+				Device:        "eth0",
+				MBits:         150,
+				ReservedPorts: []Port{{"ssh", 22}, {"web", 80}},
+			},
+			{
+				Device: "eth1",
+				MBits:  10,
+			},
+		},
+	}
+
+	sum := r1.Plus(r2)
+	require.Equal(expect, sum)
+	require.Equal(r1Orig, r1)
+	require.Equal(r2Orig, r2)
+
+	// Modifying the sum must not leak into either operand
+	sum.Networks[0].MBits = 1
+	sum.Networks[1].MBits = 1
+	require.Equal(r1Orig, r1)
+	require.Equal(r2Orig, r2)
+
+	require.Equal(r1Orig, r1.Plus(nil))
+	require.Equal(r2Orig, (*Resources)(nil).Plus(r2))
+}
+
+func TestResource_Validate(t *testing.T) {
+	cases := []struct {
+		Name      string
+		Resources *Resources
+		Err       string
+	}{
+		{
+			Name: "valid",
+			Resources: &Resources{
+				CPU:      500,
+				MemoryMB: 256,
+				Networks: []*NetworkResource{
+					{
+						Device:        "eth0",
+						CIDR:          "10.0.0.0/8",
+						IP:            "10.0.0.1",
+						MBits:         50,
+						ReservedPorts: []Port{{"web", 80}},
+					},
+				},
+			},
+		},
+		{
+			Name:      "negative cpu",
+			Resources: &Resources{CPU: -1, MemoryMB: 256},
+			Err:       "CPU cannot be negative",
+		},
+		{
+			Name:      "negative disk",
+			Resources: &Resources{CPU: 500, DiskMB: -10},
+			Err:       "DiskMB cannot be negative",
+		},
+		{
+			Name: "nil network",
+			Resources: &Resources{
+				CPU:      500,
+				Networks: []*NetworkResource{nil},
+			},
+			Err: "network resource at index 0 is nil",
+		},
+		{
+			Name: "negative mbits",
+			Resources: &Resources{
+				Networks: []*NetworkResource{{MBits: -5}},
+			},
+			Err: "MBits cannot be negative",
+		},
+		{
+			Name: "malformed cidr",
+			Resources: &Resources{
+				Networks: []*NetworkResource{{CIDR: "10.0.0.0"}},
+			},
+			Err: "invalid CIDR",
+		},
+		{
+			Name: "malformed ip",
+			Resources: &Resources{
+				Networks: []*NetworkResource{{IP: "10.0.0"}},
+			},
+			Err: "invalid IP",
+		},
+		{
+			Name: "port out of range",
+			Resources: &Resources{
+				Networks: []*NetworkResource{
+					{
+						MBits:         10,
+						ReservedPorts: []Port{{"web", 70000}},
+					},
+				},
+			},
+			Err: `reserved port "web" has invalid value 70000`,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.Name, func(t *testing.T) {
+			err := c.Resources.Validate()
+			if c.Err == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.Error(t, err)
+			require.Contains(t, err.Error(), c.Err)
+		})
+	}
+}
+
+func TestResource_Scale(t *testing.T) {
+	require := require.New(t)
+
+	r := &Resources{
+		CPU:      1000,
+		MemoryMB: 333,
+		DiskMB:   100,
+		IOPS:     3,
+		Networks: []*NetworkResource{
+			{
+				Device:        "eth0",
+				MBits:         50,
+				ReservedPorts: []Port{{"web", 80}},
+			},
+		},
+	}
+
+	expect := &Resources{
+		CPU:      1100,
+		MemoryMB: 367,
+		DiskMB:   110,
+		IOPS:     4,
+		Networks: []*NetworkResource{
+			{
+				Device:        "eth0",
+				MBits:         55,
+				ReservedPorts: []Port{{"web", 80}},
+			},
+		},
+	}
+	require.Equal(expect, r.Scale(1.1))
+
+	// Scaling must not modify the receiver
+	require.Equal(1000, r.CPU)
+	require.Equal(50, r.Networks[0].MBits)
+
+	// Fractional results round up
+	half := r.Scale(0.5)
+	require.Equal(500, half.CPU)
+	require.Equal(167, half.MemoryMB)
+	require.Equal(2, half.IOPS)
+	require.Equal(25, half.Networks[0].MBits)
+
+	require.Equal(r, r.Scale(1))
+	require.Nil((*Resources)(nil).Scale(2))
+}
+
 func TestEncodeDecode(t *testing.T) {
 	type FooRequest struct {
 		Foo string
@@ -31,40 +31,22 @@ func TestBinPackIterator_NoExistingAlloc(t *testing.T) {
 		{
 			Node: &structs.Node{
 				// Perfect fit
-				Resources: &structs.Resources{
-					CPU:      2048,
-					MemoryMB: 2048,
-				},
-				Reserved: &structs.Resources{
-					CPU:      1024,
-					MemoryMB: 1024,
-				},
+				Resources: mock.Resources(2048, 2048, 0),
+				Reserved:  mock.Resources(1024, 1024, 0),
 			},
 		},
 		{
 			Node: &structs.Node{
 				// Overloaded
-				Resources: &structs.Resources{
-					CPU:      1024,
-					MemoryMB: 1024,
-				},
-				Reserved: &structs.Resources{
-					CPU:      512,
-					MemoryMB: 512,
-				},
+				Resources: mock.Resources(1024, 1024, 0),
+				Reserved:  mock.Resources(512, 512, 0),
 			},
 		},
 		{
 			Node: &structs.Node{
 				// 50% fit
-				Resources: &structs.Resources{
-					CPU:      4096,
-					MemoryMB: 4096,
-				},
-				Reserved: &structs.Resources{
-					CPU:      1024,
-					MemoryMB: 1024,
-				},
+				Resources: mock.Resources(4096, 4096, 0),
+				Reserved:  mock.Resources(1024, 1024, 0),
 			},
 		},
 	}
@@ -74,11 +56,8 @@ func TestBinPackIterator_NoExistingAlloc(t *testing.T) {
 		EphemeralDisk: &structs.EphemeralDisk{},
 		Tasks: []*structs.Task{
 			{
-				Name: "web",
-				Resources: &structs.Resources{
-					CPU:      1024,
-					MemoryMB: 1024,
-				},
+				Name:      "web",
+				Resources: mock.Resources(1024, 1024, 0),
 			},
 		},
 	}
@@ -107,21 +86,15 @@ func TestBinPackIterator_PlannedAlloc(t *testing.T) {
 		{
 			Node: &structs.Node{
 				// Perfect fit
-				ID: uuid.Generate(),
-				Resources: &structs.Resources{
-					CPU:      2048,
-					MemoryMB: 2048,
-				},
+				ID:        uuid.Generate(),
+				Resources: mock.Resources(2048, 2048, 0),
 			},
 		},
 		{
 			Node: &structs.Node{
 				// Perfect fit
-				ID: uuid.Generate(),
-				Resources: &structs.Resources{
-					CPU:      2048,
-					MemoryMB: 2048,
-				},
+				ID:        uuid.Generate(),
+				Resources: mock.Resources(2048, 2048, 0),
 			},
 		},
 	}
@@ -131,20 +104,14 @@ func TestBinPackIterator_PlannedAlloc(t *testing.T) {
 	plan := ctx.Plan()
 	plan.NodeAllocation[nodes[0].Node.ID] = []*structs.Allocation{
 		{
-			Resources: &structs.Resources{
-				CPU:      2048,
-				MemoryMB: 2048,
-			},
+			Resources: mock.Resources(2048, 2048, 0),
 		},
 	}
 
 	// Add a planned alloc to node2 that half fills it
 	plan.NodeAllocation[nodes[1].Node.ID] = []*structs.Allocation{
 		{
-			Resources: &structs.Resources{
-				CPU:      1024,
-				MemoryMB: 1024,
-			},
+			Resources: mock.Resources(1024, 1024, 0),
 		},
 	}
 
@@ -152,11 +119,8 @@ func TestBinPackIterator_PlannedAlloc(t *testing.T) {
 		EphemeralDisk: &structs.EphemeralDisk{},
 		Tasks: []*structs.Task{
 			{
-				Name: "web",
-				Resources: &structs.Resources{
-					CPU:      1024,
-					MemoryMB: 1024,
-				},
+				Name:      "web",
+				Resources: mock.Resources(1024, 1024, 0),
 			},
 		},
 	}